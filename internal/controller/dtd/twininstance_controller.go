@@ -27,17 +27,26 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	dtdv0 "ktwin/operator/api/dtd/v0"
 )
 
+// twinInstanceFinalizer lets the reconciler tear down the EventStore entries
+// backing this TwinInstance before it's removed. The MQTT dispatcher
+// Bindings are owned via OwnerReferences and cascade-delete for free; they
+// don't need finalizer logic.
+const twinInstanceFinalizer = "dtd.ktwin/finalizer"
+
 // TwinInstanceReconciler reconciles a TwinInstance object
 type TwinInstanceReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    record.EventRecorder
 	TwinService twinservice.TwinService
 	TwinEvent   twinevent.TwinEvent
 	EventStore  eventStore.EventStore
@@ -62,6 +71,34 @@ func (r *TwinInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
+	// Finalize scenario: tear down the EventStore entries backing this
+	// TwinInstance. The MQTT dispatcher Bindings cascade-delete via
+	// owner-references and don't need handling here.
+	if !twinInstance.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(twinInstance, twinInstanceFinalizer) {
+			if err := r.finalizeTwinInstance(ctx, twinInstance); err != nil {
+				logger.Error(err, fmt.Sprintf("Error while finalizing TwinInstance %s", req.Name))
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(twinInstance, twinInstanceFinalizer)
+			if err := r.Update(ctx, twinInstance); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			r.Recorder.Event(twinInstance, "Normal", "Finalized", "TwinInstance resources were torn down")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(twinInstance, twinInstanceFinalizer) {
+		controllerutil.AddFinalizer(twinInstance, twinInstanceFinalizer)
+		if err := r.Update(ctx, twinInstance); err != nil {
+			logger.Error(err, fmt.Sprintf("Error while adding finalizer to TwinInstance %s", req.Name))
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Get parent TwinInterface
 	twinInterface := &dtdv0.TwinInterface{}
 	twinInterfaceName := twinInstance.Spec.Interface
@@ -75,6 +112,20 @@ func (r *TwinInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	return r.createUpdateTwinInstance(ctx, req, twinInstance, twinInterface)
 }
 
+// finalizeTwinInstance tears down the EventStore entries backing this
+// TwinInstance. They live outside Kubernetes and can't rely on
+// owner-reference cascade deletion, unlike the MQTT dispatcher Bindings.
+func (r *TwinInstanceReconciler) finalizeTwinInstance(ctx context.Context, twinInstance *dtdv0.TwinInstance) error {
+	logger := log.FromContext(ctx)
+
+	if err := r.EventStore.DeleteTwinInstance(twinInstance); err != nil {
+		logger.Error(err, fmt.Sprintf("Error while deleting EventStore entries for TwinInstance %s", twinInstance.Name))
+		return err
+	}
+
+	return nil
+}
+
 func (r *TwinInstanceReconciler) createUpdateTwinInstance(ctx context.Context, req ctrl.Request, twinInstance *dtdv0.TwinInstance, twinInterface *dtdv0.TwinInterface) (ctrl.Result, error) {
 	twinInterfaceName := twinInstance.ObjectMeta.Name
 
@@ -84,6 +135,12 @@ func (r *TwinInstanceReconciler) createUpdateTwinInstance(ctx context.Context, r
 	bindings := r.TwinEvent.GetMQQTDispatcherBindings(twinInstance)
 
 	for _, binding := range bindings {
+		binding := binding
+		if err := controllerutil.SetControllerReference(twinInstance, &binding, r.Scheme); err != nil {
+			logger.Error(err, fmt.Sprintf("Error while setting owner reference on Binding %s", binding.Name))
+			resultErrors = append(resultErrors, err)
+			continue
+		}
 		err := r.Create(ctx, &binding, &client.CreateOptions{})
 		if err != nil && !errors.IsAlreadyExists(err) {
 			logger.Error(err, fmt.Sprintf("Error while creating TwinInterface Binding %s", binding.Name))
@@ -93,8 +150,12 @@ func (r *TwinInstanceReconciler) createUpdateTwinInstance(ctx context.Context, r
 
 	if len(resultErrors) > 0 {
 		twinInstance.Status.Status = dtdv0.TwinInstancePhaseFailed
+		r.Recorder.Event(twinInstance, "Warning", "ReconcileFailed", resultErrors[0].Error())
 		return ctrl.Result{}, resultErrors[0]
 	} else {
+		if twinInstance.Status.Status != dtdv0.TwinInstancePhaseRunning {
+			r.Recorder.Event(twinInstance, "Normal", "Running", "TwinInstance resources are ready")
+		}
 		twinInstance.Status.Status = dtdv0.TwinInstancePhaseRunning
 	}
 
@@ -126,6 +187,8 @@ func (r *TwinInstanceReconciler) updateTwinInstance(ctx context.Context, req ctr
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TwinInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("twininstance-controller")
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&dtdv0.TwinInstance{}).
 		Complete(r)
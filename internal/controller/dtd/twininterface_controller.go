@@ -21,27 +21,90 @@ import (
 	"fmt"
 
 	rabbitmqv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	eventingrabbitmqv1alpha1 "knative.dev/eventing-rabbitmq/pkg/apis/eventing/v1alpha1"
+	keventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	kmessaging "knative.dev/eventing/pkg/apis/messaging/v1"
+	"knative.dev/pkg/apis"
+	kserving "knative.dev/serving/pkg/apis/serving/v1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dtdv0 "ktwin/operator/api/dtd/v0"
 	twinevent "ktwin/operator/pkg/event"
+	"ktwin/operator/pkg/event/converters"
 	eventStore "ktwin/operator/pkg/event-store"
+	"ktwin/operator/pkg/eventtransport"
 	twinservice "ktwin/operator/pkg/service"
 )
 
+// twinInterfaceFinalizer lets the reconciler tear down the EventStore entries
+// backing this TwinInterface before it's removed. Owned Kubernetes objects
+// (Service, Trigger, Bindings) are cleaned up for free via OwnerReferences.
+const twinInterfaceFinalizer = "dtd.ktwin/finalizer"
+
+// Known Kind values for TwinInterface.Spec.BrokerRef.
+const (
+	BrokerRefKindBroker               = "Broker"
+	BrokerRefKindRabbitmqCluster      = "RabbitmqCluster"
+	BrokerRefKindRabbitmqBrokerConfig = "RabbitmqBrokerConfig"
+
+	// defaultBrokerName preserves the previous single-tenant behavior when
+	// a TwinInterface does not set a BrokerRef.
+	defaultBrokerName = "ktwin"
+)
+
+// Condition types tracked on TwinInterface.Status.Conditions. ConditionReady
+// is the aggregate of all of them, following the knative.dev/pkg/apis
+// pattern so `kubectl wait --for=condition=Ready twininterface/...` works.
+const (
+	TwinInterfaceConditionServiceReady            apis.ConditionType = "ServiceReady"
+	TwinInterfaceConditionTriggerReady            apis.ConditionType = "TriggerReady"
+	TwinInterfaceConditionBindingsReady           apis.ConditionType = "BindingsReady"
+	TwinInterfaceConditionEventStoreBindingsReady apis.ConditionType = "EventStoreBindingsReady"
+)
+
+// twinInterfaceConditionSet declares ConditionReady as the AND of the
+// dependent conditions above.
+var twinInterfaceConditionSet = apis.NewLivingConditionSet(
+	TwinInterfaceConditionServiceReady,
+	TwinInterfaceConditionTriggerReady,
+	TwinInterfaceConditionBindingsReady,
+	TwinInterfaceConditionEventStoreBindingsReady,
+)
+
 // TwinInterfaceReconciler reconciles a TwinInterface object
 type TwinInterfaceReconciler struct {
 	client.Client
 	Scheme      *runtime.Scheme
+	Recorder    record.EventRecorder
 	TwinService twinservice.TwinService
 	TwinEvent   twinevent.TwinEvent
 	EventStore  eventStore.EventStore
+	// DefaultTransport selects the EventTransport backend used when a
+	// TwinInterface does not set Spec.Transport. Defaults to
+	// eventtransport.BackendRabbitMQ when unset.
+	DefaultTransport eventtransport.Backend
+}
+
+// resolveEventTransport picks the EventTransport backend for a TwinInterface,
+// preferring its own Spec.Transport and falling back to the controller-wide
+// DefaultTransport.
+func (r *TwinInterfaceReconciler) resolveEventTransport(twinInterface *dtdv0.TwinInterface) eventtransport.EventTransport {
+	backend := twinInterface.Spec.Transport
+	if backend == "" {
+		backend = r.DefaultTransport
+	}
+	return eventtransport.NewEventTransport(backend, r.Client, r.Scheme, r.TwinEvent, r.EventStore)
 }
 
 //+kubebuilder:rbac:groups=dtd.ktwin,resources=twininterfaces,verbs=get;list;watch;create;update;patch;delete
@@ -63,11 +126,54 @@ func (r *TwinInterfaceReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
+	// Finalize scenario: the TwinInterface is marked for deletion, tear down
+	// the EventStore entries backing it before letting it go. Owned objects
+	// (Service, Trigger, Bindings) cascade-delete via OwnerReferences.
+	if !twinInterface.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(twinInterface, twinInterfaceFinalizer) {
+			if err := r.finalizeTwinInterface(ctx, twinInterface); err != nil {
+				logger.Error(err, fmt.Sprintf("Error while finalizing TwinInterface %s", req.Name))
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(twinInterface, twinInterfaceFinalizer)
+			if err := r.Update(ctx, twinInterface); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			r.Recorder.Event(twinInterface, "Normal", "Finalized", "TwinInterface resources were torn down")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(twinInterface, twinInterfaceFinalizer) {
+		controllerutil.AddFinalizer(twinInterface, twinInterfaceFinalizer)
+		if err := r.Update(ctx, twinInterface); err != nil {
+			logger.Error(err, fmt.Sprintf("Error while adding finalizer to TwinInterface %s", req.Name))
+			return ctrl.Result{}, err
+		}
+	}
+
 	// TODO: Create Entry in Event Store
 
 	return r.createUpdateTwinInterface(ctx, req, twinInterface)
 }
 
+// finalizeTwinInterface tears down the EventStore entries that back this
+// TwinInterface. They live in the EventStore service and can't rely on
+// Kubernetes garbage collection via OwnerReferences, unlike the RabbitMQ
+// Bindings, which are owned objects.
+func (r *TwinInterfaceReconciler) finalizeTwinInterface(ctx context.Context, twinInterface *dtdv0.TwinInterface) error {
+	logger := log.FromContext(ctx)
+
+	if err := r.EventStore.DeleteTwinInterface(twinInterface); err != nil {
+		logger.Error(err, fmt.Sprintf("Error while deleting EventStore entries for TwinInterface %s", twinInterface.Name))
+		return err
+	}
+
+	return nil
+}
+
 func (r *TwinInterfaceReconciler) createUpdateTwinInterface(ctx context.Context, req ctrl.Request, twinInterface *dtdv0.TwinInterface) (ctrl.Result, error) {
 	twinInterfaceName := twinInterface.ObjectMeta.Name
 
@@ -77,6 +183,11 @@ func (r *TwinInterfaceReconciler) createUpdateTwinInterface(ctx context.Context,
 	// Create Service Instance and Trigger, if pod is specified
 	if twinInterface.Spec.Service != nil {
 		kService := r.TwinService.GetService(twinInterface)
+		injectTelemetryFormatEnv(kService, twinInterface)
+		if err := controllerutil.SetControllerReference(twinInterface, kService, r.Scheme); err != nil {
+			logger.Error(err, fmt.Sprintf("Error while setting owner reference on Knative Service %s", twinInterfaceName))
+			resultErrors = append(resultErrors, err)
+		}
 		err := r.Create(ctx, kService, &client.CreateOptions{})
 
 		if err != nil && !errors.IsAlreadyExists(err) {
@@ -86,6 +197,10 @@ func (r *TwinInterfaceReconciler) createUpdateTwinInterface(ctx context.Context,
 
 		// Create Trigger
 		trigger := r.TwinEvent.GetTwinInterfaceTrigger(twinInterface)
+		if err := controllerutil.SetControllerReference(twinInterface, &trigger, r.Scheme); err != nil {
+			logger.Error(err, fmt.Sprintf("Error while setting owner reference on Trigger %s", twinInterfaceName))
+			resultErrors = append(resultErrors, err)
+		}
 		err = r.Create(ctx, &trigger, &client.CreateOptions{})
 		if err != nil && !errors.IsAlreadyExists(err) {
 			logger.Error(err, fmt.Sprintf("Error while creating Twin Events %s", twinInterfaceName))
@@ -99,92 +214,82 @@ func (r *TwinInterfaceReconciler) createUpdateTwinInterface(ctx context.Context,
 			resultErrors = append(resultErrors, err)
 		}
 
-		// Create Relationship RabbitMQ bindings to existing Queue and Eventing
-		// RabbitMQ exchange (Broker): https://github.com/knative-extensions/eventing-rabbitmq/blob/main/pkg/reconciler/broker/broker.go#L133
-		// RabbitMQ Queue (Trigger): https://github.com/knative-extensions/eventing-rabbitmq/blob/main/pkg/reconciler/trigger/trigger.go#L233
+		// Provision the broker-side topology and the bindings that wire this
+		// TwinInterface's Trigger to it. The concrete messaging technology
+		// (RabbitMQ, CloudEvents/Knative Channels, ...) is resolved per
+		// TwinInterface so each tenant can pick what's available in its
+		// cluster.
 		// Deletion: Can use ownerReferences for deletion in cascade
 
-		eventStoreQueuesList := rabbitmqv1beta1.QueueList{}
-		queueListOptions := []client.ListOption{
-			client.InNamespace(twinInterface.Namespace),
-			client.MatchingLabels(client.MatchingFields{
-				"eventing.knative.dev/trigger": "event-store-trigger",
-			}),
+		// Unlike the Queue/Exchange lookups below, there's no Watch on
+		// Broker/RabbitmqCluster/RabbitmqBrokerConfig, so a BrokerRef that
+		// doesn't resolve yet falls back to plain exponential-backoff
+		// requeue here rather than the watch-triggered retry pattern used
+		// once the topology/bindings are reached.
+		brokerName, err := r.resolveBrokerName(ctx, twinInterface)
+		if err != nil {
+			logger.Error(err, fmt.Sprintf("Error while resolving BrokerRef for TwinInterface %s", twinInterfaceName))
+			resultErrors = append(resultErrors, err)
+			return ctrl.Result{}, err
 		}
 
-		err = r.List(ctx, &eventStoreQueuesList, queueListOptions...)
+		transport := r.resolveEventTransport(twinInterface)
 
-		if len(eventStoreQueuesList.Items) == 0 {
-			logger.Error(err, fmt.Sprintf("No Queue found for event store %s", twinInterfaceName))
+		topology, err := transport.EnsureInterfaceTopology(ctx, twinInterface, brokerName)
+		if err != nil {
+			logger.Error(err, fmt.Sprintf("Error while resolving event transport topology for TwinInterface %s", twinInterfaceName))
 			resultErrors = append(resultErrors, err)
 			return ctrl.Result{}, err
 		}
 
-		exchangeList := rabbitmqv1beta1.ExchangeList{}
-		exchangeListOptions := []client.ListOption{
-			client.InNamespace(twinInterface.Namespace),
-			client.MatchingLabels(client.MatchingFields{
-				"eventing.knative.dev/broker": "ktwin",
-			}),
+		if topology == nil {
+			// The broker dataplane may not have materialized yet. Rather than
+			// erroring (and forcing an exponential backoff requeue), return
+			// cleanly: the Watches registered in SetupWithManager re-trigger
+			// this reconcile as soon as the topology is created.
+			logger.Info(fmt.Sprintf("Event transport topology not ready yet for TwinInterface %s, waiting for it to be created", twinInterfaceName))
+			return ctrl.Result{}, nil
 		}
 
-		err = r.List(ctx, &exchangeList, exchangeListOptions...)
-
+		bindings, err := transport.EnsureRelationshipBindings(ctx, twinInterface, trigger, topology)
 		if err != nil {
-			logger.Error(err, fmt.Sprintf("Error while getting default broker exchange"))
+			logger.Error(err, fmt.Sprintf("Error while building event transport bindings for TwinInterface %s", twinInterfaceName))
 			resultErrors = append(resultErrors, err)
+			return ctrl.Result{}, err
 		}
 
-		if len(exchangeList.Items) == 0 {
-			logger.Error(err, fmt.Sprintf("No Broker Exchange found for TwinInterface %s", twinInterfaceName))
-			resultErrors = append(resultErrors, err)
-		} else {
-			queueList := rabbitmqv1beta1.QueueList{}
-			queueListOptions := []client.ListOption{
-				client.InNamespace(twinInterface.Namespace),
-				client.MatchingLabels(client.MatchingFields{
-					"eventing.knative.dev/broker":  "ktwin",
-					"eventing.knative.dev/trigger": twinInterface.Name,
-				}),
+		for _, binding := range bindings {
+			if err := controllerutil.SetControllerReference(twinInterface, binding, r.Scheme); err != nil {
+				logger.Error(err, fmt.Sprintf("Error while setting owner reference on Binding %s", binding.GetName()))
+				resultErrors = append(resultErrors, err)
+				continue
 			}
-
-			err = r.List(ctx, &queueList, queueListOptions...)
-
-			if len(queueList.Items) == 0 {
-				logger.Error(err, fmt.Sprintf("No Broker Queue found for TwinInterface %s", twinInterfaceName))
+			err = r.Create(ctx, binding, &client.CreateOptions{})
+			if err != nil && !errors.IsAlreadyExists(err) {
+				logger.Error(err, fmt.Sprintf("Error while creating TwinInterface Binding %s", binding.GetName()))
 				resultErrors = append(resultErrors, err)
-			} else {
-				brokerExchange := exchangeList.Items[0]
-				twinInterfaceQueue := queueList.Items[0]
-				bindings := r.TwinEvent.GetRelationshipBrokerBindings(twinInterface, trigger, brokerExchange, twinInterfaceQueue)
-
-				for _, binding := range bindings {
-					err = r.Create(ctx, &binding, &client.CreateOptions{})
-					if err != nil && !errors.IsAlreadyExists(err) {
-						logger.Error(err, fmt.Sprintf("Error while creating TwinInterface Binding %s", binding.Name))
-						resultErrors = append(resultErrors, err)
-					}
-				}
-
-				eventStoreQueue := eventStoreQueuesList.Items[0]
-				bindings = r.EventStore.GetEventStoreBrokerBindings(twinInterface, trigger, brokerExchange, eventStoreQueue)
-
-				for _, binding := range bindings {
-					err = r.Create(ctx, &binding, &client.CreateOptions{})
-					if err != nil && !errors.IsAlreadyExists(err) {
-						logger.Error(err, fmt.Sprintf("Error while creating EventStore TwinInterface Bindings %s", binding.Name))
-						resultErrors = append(resultErrors, err)
-					}
-				}
-
 			}
 		}
+
+		r.updateChildReadiness(ctx, twinInterface, kService, trigger, bindings)
+	} else {
+		// No child resources are created for a TwinInterface without a
+		// Service, so there is nothing to wait on.
+		twinInterfaceConditionSet.Manage(&twinInterface.Status).MarkTrue(TwinInterfaceConditionServiceReady)
+		twinInterfaceConditionSet.Manage(&twinInterface.Status).MarkTrue(TwinInterfaceConditionTriggerReady)
+		twinInterfaceConditionSet.Manage(&twinInterface.Status).MarkTrue(TwinInterfaceConditionBindingsReady)
+		twinInterfaceConditionSet.Manage(&twinInterface.Status).MarkTrue(TwinInterfaceConditionEventStoreBindingsReady)
+		twinInterface.Status.ObservedGeneration = twinInterface.Generation
 	}
 
 	if len(resultErrors) > 0 {
 		twinInterface.Status.Status = dtdv0.TwinInterfacePhaseFailed
+		r.Recorder.Event(twinInterface, "Warning", "ReconcileFailed", resultErrors[0].Error())
 		return ctrl.Result{}, resultErrors[0]
 	} else {
+		if twinInterface.Status.Status != dtdv0.TwinInterfacePhaseRunning {
+			r.Recorder.Event(twinInterface, "Normal", "Running", "TwinInterface resources are ready")
+		}
 		twinInterface.Status.Status = dtdv0.TwinInterfacePhaseRunning
 	}
 
@@ -202,6 +307,183 @@ func (r *TwinInterfaceReconciler) createUpdateTwinInterface(ctx context.Context,
 	return ctrl.Result{}, nil
 }
 
+// updateChildReadiness refreshes TwinInterface.Status.Conditions, .URL, and
+// .ObservedGeneration from the latest observed state of its child Service,
+// Trigger, and transport Bindings. kService, trigger, and bindings are
+// re-fetched because the copies created earlier in createUpdateTwinInterface
+// don't carry the status Knative/RabbitMQ controllers populate asynchronously.
+func (r *TwinInterfaceReconciler) updateChildReadiness(ctx context.Context, twinInterface *dtdv0.TwinInterface, kService *kserving.Service, trigger keventing.Trigger, bindings []client.Object) {
+	logger := log.FromContext(ctx)
+	manager := twinInterfaceConditionSet.Manage(&twinInterface.Status)
+
+	latestService := &kserving.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: kService.Namespace, Name: kService.Name}, latestService); err != nil {
+		logger.Error(err, fmt.Sprintf("Error while reading Knative Service status for TwinInterface %s", twinInterface.Name))
+		manager.MarkUnknown(TwinInterfaceConditionServiceReady, "ServiceNotFound", err.Error())
+	} else {
+		twinInterface.Status.URL = latestService.Status.URL
+		if cond := latestService.Status.GetCondition(apis.ConditionReady); cond != nil && cond.Status == corev1.ConditionTrue {
+			manager.MarkTrue(TwinInterfaceConditionServiceReady)
+		} else {
+			reason, message := "ServiceNotReady", "Knative Service is not ready yet"
+			if cond != nil {
+				reason, message = cond.Reason, cond.Message
+			}
+			manager.MarkFalse(TwinInterfaceConditionServiceReady, reason, message)
+		}
+	}
+
+	latestTrigger := &keventing.Trigger{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: trigger.Namespace, Name: trigger.Name}, latestTrigger); err != nil {
+		logger.Error(err, fmt.Sprintf("Error while reading Trigger status for TwinInterface %s", twinInterface.Name))
+		manager.MarkUnknown(TwinInterfaceConditionTriggerReady, "TriggerNotFound", err.Error())
+	} else if cond := latestTrigger.Status.GetCondition(apis.ConditionReady); cond != nil && cond.Status == corev1.ConditionTrue {
+		manager.MarkTrue(TwinInterfaceConditionTriggerReady)
+	} else {
+		reason, message := "TriggerNotReady", "Trigger is not ready yet"
+		if cond != nil {
+			reason, message = cond.Reason, cond.Message
+		}
+		manager.MarkFalse(TwinInterfaceConditionTriggerReady, reason, message)
+	}
+
+	latestBindings := make([]client.Object, 0, len(bindings))
+	for _, binding := range bindings {
+		latest := binding.DeepCopyObject().(client.Object)
+		if err := r.Get(ctx, client.ObjectKeyFromObject(binding), latest); err != nil {
+			logger.Error(err, fmt.Sprintf("Error while reading Binding %s status for TwinInterface %s", binding.GetName(), twinInterface.Name))
+		}
+		latestBindings = append(latestBindings, latest)
+	}
+
+	if ready, reason, message := bindingsReady(latestBindings); ready {
+		manager.MarkTrue(TwinInterfaceConditionBindingsReady)
+	} else {
+		manager.MarkFalse(TwinInterfaceConditionBindingsReady, reason, message)
+	}
+
+	// The transport returns the EventStore bindings together with the
+	// relationship bindings, so their readiness is already counted in
+	// BindingsReady above; this condition is always marked true.
+	manager.MarkTrue(TwinInterfaceConditionEventStoreBindingsReady)
+
+	twinInterface.Status.ObservedGeneration = twinInterface.Generation
+}
+
+// bindingsReady reports whether every transport binding has reached its own
+// Ready condition. Bindings are backend-specific (RabbitMQ Binding vs.
+// Knative Subscription), so each concrete type is inspected in turn; an
+// unrecognized binding type is treated as ready once created.
+func bindingsReady(bindings []client.Object) (ready bool, reason string, message string) {
+	for _, binding := range bindings {
+		switch b := binding.(type) {
+		case *rabbitmqv1beta1.Binding:
+			bindingReady := false
+			for _, cond := range b.Status.Conditions {
+				if string(cond.Type) == "Ready" && cond.Status == corev1.ConditionTrue {
+					bindingReady = true
+					break
+				}
+			}
+			if !bindingReady {
+				return false, "BindingNotReady", fmt.Sprintf("RabbitMQ Binding %s is not ready yet", b.Name)
+			}
+		case *kmessaging.Subscription:
+			if cond := b.Status.GetCondition(apis.ConditionReady); cond == nil || cond.Status != corev1.ConditionTrue {
+				return false, "BindingNotReady", fmt.Sprintf("Subscription %s is not ready yet", b.Name)
+			}
+		case *kmessaging.Channel:
+			if cond := b.Status.GetCondition(apis.ConditionReady); cond == nil || cond.Status != corev1.ConditionTrue {
+				return false, "BindingNotReady", fmt.Sprintf("Channel %s is not ready yet", b.Name)
+			}
+		}
+	}
+	return true, "", ""
+}
+
+// injectTelemetryFormatEnv sets the KTWIN_CONVERTER_FORMAT env var on every
+// container of the Knative Service so the dispatcher sidecar picks up the
+// Converter matching Spec.Telemetry.Format. A no-op when Telemetry is unset,
+// which leaves the dispatcher on its own default (JSON).
+func injectTelemetryFormatEnv(kService *kserving.Service, twinInterface *dtdv0.TwinInterface) {
+	if twinInterface.Spec.Telemetry == nil || twinInterface.Spec.Telemetry.Format == "" {
+		return
+	}
+
+	containers := kService.Spec.ConfigurationSpec.Template.Spec.PodSpec.Containers
+	for i := range containers {
+		containers[i].Env = append(containers[i].Env, corev1.EnvVar{
+			Name:  converters.EnvFormatKey,
+			Value: string(twinInterface.Spec.Telemetry.Format),
+		})
+	}
+}
+
+// resolveBrokerName resolves the broker label value to use when looking up
+// the RabbitMQ Exchange/Queues backing a TwinInterface. When BrokerRef is
+// unset it falls back to the shared "ktwin" broker, preserving the previous
+// single-tenant behavior.
+func (r *TwinInterfaceReconciler) resolveBrokerName(ctx context.Context, twinInterface *dtdv0.TwinInterface) (string, error) {
+	brokerRef := twinInterface.Spec.BrokerRef
+
+	if brokerRef == nil {
+		return defaultBrokerName, nil
+	}
+
+	namespace := brokerRef.Namespace
+	if namespace == "" {
+		namespace = twinInterface.Namespace
+	}
+
+	switch brokerRef.Kind {
+	case BrokerRefKindBroker, "":
+		broker := &keventing.Broker{}
+		if err := r.Get(ctx, types.NamespacedName{Name: brokerRef.Name, Namespace: namespace}, broker); err != nil {
+			return "", err
+		}
+		return broker.Name, nil
+	case BrokerRefKindRabbitmqCluster:
+		cluster := &rabbitmqv1beta1.RabbitmqCluster{}
+		if err := r.Get(ctx, types.NamespacedName{Name: brokerRef.Name, Namespace: namespace}, cluster); err != nil {
+			return "", err
+		}
+		return r.resolveBrokerFromConfigRef(ctx, twinInterface, namespace, brokerRef)
+	case BrokerRefKindRabbitmqBrokerConfig:
+		brokerConfig := &eventingrabbitmqv1alpha1.RabbitmqBrokerConfig{}
+		if err := r.Get(ctx, types.NamespacedName{Name: brokerRef.Name, Namespace: namespace}, brokerConfig); err != nil {
+			return "", err
+		}
+		return r.resolveBrokerFromConfigRef(ctx, twinInterface, namespace, brokerRef)
+	default:
+		return "", fmt.Errorf("unsupported BrokerRef kind %q on TwinInterface %s", brokerRef.Kind, twinInterface.Name)
+	}
+}
+
+// resolveBrokerFromConfigRef finds the Knative Broker whose spec.config
+// points at the referenced RabbitmqCluster/RabbitmqBrokerConfig and returns
+// its name. The eventing.knative.dev/broker label on Exchange/Queue objects
+// is populated from that Broker's name, not the name of the RabbitMQ
+// cluster/config it wraps, so BrokerRef kinds other than "Broker" have to be
+// resolved indirectly through it.
+func (r *TwinInterfaceReconciler) resolveBrokerFromConfigRef(ctx context.Context, twinInterface *dtdv0.TwinInterface, namespace string, brokerRef *dtdv0.BrokerRef) (string, error) {
+	brokerList := &keventing.BrokerList{}
+	if err := r.List(ctx, brokerList, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+
+	for _, broker := range brokerList.Items {
+		config := broker.Spec.Config
+		if config == nil {
+			continue
+		}
+		if config.Kind == brokerRef.Kind && config.Name == brokerRef.Name {
+			return broker.Name, nil
+		}
+	}
+
+	return "", fmt.Errorf("no Broker in namespace %s references %s %q for TwinInterface %s", namespace, brokerRef.Kind, brokerRef.Name, twinInterface.Name)
+}
+
 func (r *TwinInterfaceReconciler) updateTwinInterface(ctx context.Context, req ctrl.Request, twinInterface *dtdv0.TwinInterface) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	err := r.Update(ctx, twinInterface, &client.UpdateOptions{})
@@ -216,7 +498,130 @@ func (r *TwinInterfaceReconciler) updateTwinInterface(ctx context.Context, req c
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TwinInterfaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctx := context.Background()
+
+	r.Recorder = mgr.GetEventRecorderFor("twininterface-controller")
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &rabbitmqv1beta1.Queue{}, eventtransport.TriggerLabel, indexByLabel(eventtransport.TriggerLabel)); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &rabbitmqv1beta1.Queue{}, eventtransport.BrokerLabel, indexByLabel(eventtransport.BrokerLabel)); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &rabbitmqv1beta1.Exchange{}, eventtransport.BrokerLabel, indexByLabel(eventtransport.BrokerLabel)); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &rabbitmqv1beta1.Queue{}, eventtransport.QueueBrokerTriggerIndex, indexByBrokerTrigger); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&dtdv0.TwinInterface{}).
+		Watches(&rabbitmqv1beta1.Queue{}, handler.EnqueueRequestsFromMapFunc(r.mapQueueToTwinInterface)).
+		Watches(&rabbitmqv1beta1.Exchange{}, handler.EnqueueRequestsFromMapFunc(r.mapExchangeToTwinInterface)).
+		// These are owned via OwnerReferences (see createUpdateTwinInterface),
+		// so Owns() re-triggers the parent TwinInterface reconcile whenever
+		// their status changes, keeping Status.Conditions accurate instead of
+		// only refreshing on the TwinInterface's own resync interval.
+		Owns(&kserving.Service{}).
+		Owns(&keventing.Trigger{}).
+		Owns(&rabbitmqv1beta1.Binding{}).
+		Owns(&kmessaging.Channel{}).
+		Owns(&kmessaging.Subscription{}).
 		Complete(r)
 }
+
+// indexByLabel builds a client.IndexerFunc that surfaces the value of the
+// given label, if present, so Queues/Exchanges can be looked up from the
+// cache via client.MatchingFields instead of a live List against the API
+// server.
+func indexByLabel(label string) client.IndexerFunc {
+	return func(obj client.Object) []string {
+		value, ok := obj.GetLabels()[label]
+		if !ok {
+			return nil
+		}
+		return []string{value}
+	}
+}
+
+// indexByBrokerTrigger surfaces the composite eventtransport.QueueBrokerTriggerIndex
+// value for a Queue, so the per-interface Queue lookup (which must match on
+// both BrokerLabel and TriggerLabel) can be served by a single exact-match
+// field selector instead of client.MatchingFields with two keys.
+func indexByBrokerTrigger(obj client.Object) []string {
+	labels := obj.GetLabels()
+	broker, ok := labels[eventtransport.BrokerLabel]
+	if !ok {
+		return nil
+	}
+	trigger, ok := labels[eventtransport.TriggerLabel]
+	if !ok {
+		return nil
+	}
+	return []string{eventtransport.BrokerTriggerIndexValue(broker, trigger)}
+}
+
+// mapQueueToTwinInterface re-triggers the owning TwinInterface reconcile as
+// soon as its Queue materializes, turning the previous requeue-via-error
+// into an automatic retry. The shared EventStore Queue is part of every
+// TwinInterface's topology, so it re-triggers every TwinInterface in its
+// namespace.
+func (r *TwinInterfaceReconciler) mapQueueToTwinInterface(ctx context.Context, obj client.Object) []reconcile.Request {
+	trigger, ok := obj.GetLabels()[eventtransport.TriggerLabel]
+	if !ok || trigger == "" {
+		return nil
+	}
+
+	if trigger == eventtransport.EventStoreTriggerName {
+		twinInterfaceList := dtdv0.TwinInterfaceList{}
+		if err := r.List(ctx, &twinInterfaceList, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, twinInterface := range twinInterfaceList.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: twinInterface.Namespace, Name: twinInterface.Name},
+			})
+		}
+		return requests
+	}
+
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: trigger}},
+	}
+}
+
+// mapExchangeToTwinInterface re-triggers only the TwinInterfaces that
+// actually resolve to the Exchange's broker once the broker's dataplane
+// materializes. Exchanges are broker-scoped rather than trigger-scoped, so
+// every TwinInterface in the namespace is a candidate, but enqueueing all of
+// them on every Exchange add/update/resync would reintroduce the O(N)
+// reconcile-storm cost this request set out to remove from the List path;
+// resolveBrokerName filters that down to the TwinInterfaces whose BrokerRef
+// (direct or via a RabbitmqCluster/RabbitmqBrokerConfig) actually matches.
+func (r *TwinInterfaceReconciler) mapExchangeToTwinInterface(ctx context.Context, obj client.Object) []reconcile.Request {
+	exchangeBroker, ok := obj.GetLabels()[eventtransport.BrokerLabel]
+	if !ok || exchangeBroker == "" {
+		return nil
+	}
+
+	twinInterfaceList := dtdv0.TwinInterfaceList{}
+	if err := r.List(ctx, &twinInterfaceList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, twinInterface := range twinInterfaceList.Items {
+		twinInterface := twinInterface
+		brokerName, err := r.resolveBrokerName(ctx, &twinInterface)
+		if err != nil || brokerName != exchangeBroker {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: twinInterface.Namespace, Name: twinInterface.Name},
+		})
+	}
+
+	return requests
+}
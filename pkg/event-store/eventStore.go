@@ -20,8 +20,8 @@ func NewEventStore() EventStore {
 
 type EventStore interface {
 	GetEventStoreService(eventStore *corev0.EventStore) *kserving.Service
-	CreateTwinInterface(twinInterface *dtdv0.TwinInstance) error
-	DeleteTwinInterface(twinInterface *dtdv0.TwinInstance) error
+	CreateTwinInterface(twinInterface *dtdv0.TwinInterface) error
+	DeleteTwinInterface(twinInterface *dtdv0.TwinInterface) error
 	CreateTwinInstance(twinInstance *dtdv0.TwinInstance) error
 	DeleteTwinInstance(twinInstance *dtdv0.TwinInstance) error
 }
@@ -95,15 +95,14 @@ func (t *eventStore) GetEventStoreService(eventStore *corev0.EventStore) *kservi
 	return service
 }
 
-func (t *eventStore) CreateTwinInterface(twinInterface *dtdv0.TwinInstance) error {
-	// TwinInstance
+func (t *eventStore) CreateTwinInterface(twinInterface *dtdv0.TwinInterface) error {
+	// TwinInterface
 
-	// Interface
 	return nil
 }
 
-func (t *eventStore) DeleteTwinInterface(twinInterface *dtdv0.TwinInstance) error {
-	// TwinInstance
+func (t *eventStore) DeleteTwinInterface(twinInterface *dtdv0.TwinInterface) error {
+	// TwinInterface
 
 	return nil
 }
@@ -0,0 +1,39 @@
+package converters
+
+import (
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// Format identifies which Converter a TwinInterface's dispatcher sidecar
+// should use for its telemetry ingestion, set via Spec.Telemetry.Format.
+type Format string
+
+const (
+	FormatJSON       Format = "JSON"
+	FormatNGSILD     Format = "NGSI-LD"
+	FormatSparkplugB Format = "SparkplugB"
+)
+
+// EnvFormatKey is the environment variable the dispatcher sidecar reads at
+// startup to pick its Converter.
+const EnvFormatKey = "KTWIN_CONVERTER_FORMAT"
+
+// Converter translates between raw device payloads, as received over MQTT,
+// and the CloudEvents the twin graph reasons about.
+type Converter interface {
+	ToCloudEvent(topic string, payload []byte) (cloudevents.Event, error)
+	FromCloudEvent(event cloudevents.Event) ([]byte, error)
+}
+
+// New resolves the Converter for the given Format, defaulting to the JSON
+// converter when empty.
+func New(format Format) Converter {
+	switch format {
+	case FormatNGSILD:
+		return &ngsiLDConverter{}
+	case FormatSparkplugB:
+		return &sparkplugBConverter{}
+	default:
+		return &jsonConverter{}
+	}
+}
@@ -0,0 +1,34 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// jsonConverter decodes the MQTT payload as a JSON object and wraps it in a
+// CloudEvent; payloads that aren't a JSON object are rejected. It's the
+// default when Spec.Telemetry.Format is unset.
+type jsonConverter struct{}
+
+func (c *jsonConverter) ToCloudEvent(topic string, payload []byte) (cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	event.SetType("dtd.ktwin.telemetry.json")
+	event.SetSource(topic)
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return event, fmt.Errorf("decoding JSON payload from topic %s: %w", topic, err)
+	}
+
+	if err := event.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
+
+func (c *jsonConverter) FromCloudEvent(event cloudevents.Event) ([]byte, error) {
+	return event.DataBytes()
+}
@@ -0,0 +1,44 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// ngsiLDPatch mirrors the subset of an NGSI-LD property/relationship update
+// payload that matches ktwin's DTDL-ish TwinInterface model.
+type ngsiLDPatch struct {
+	ID     string                 `json:"id"`
+	Type   string                 `json:"type"`
+	Value  map[string]interface{} `json:"value,omitempty"`
+	Object string                 `json:"object,omitempty"`
+}
+
+// ngsiLDConverter translates NGSI-LD property/relationship patches into
+// CloudEvents.
+type ngsiLDConverter struct{}
+
+func (c *ngsiLDConverter) ToCloudEvent(topic string, payload []byte) (cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+
+	var patch ngsiLDPatch
+	if err := json.Unmarshal(payload, &patch); err != nil {
+		return event, fmt.Errorf("decoding NGSI-LD patch from topic %s: %w", topic, err)
+	}
+
+	event.SetType("dtd.ktwin.telemetry.ngsild")
+	event.SetSource(topic)
+	event.SetSubject(patch.ID)
+
+	if err := event.SetData(cloudevents.ApplicationJSON, patch); err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
+
+func (c *ngsiLDConverter) FromCloudEvent(event cloudevents.Event) ([]byte, error) {
+	return event.DataBytes()
+}
@@ -0,0 +1,130 @@
+package eventtransport
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	keventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	kmessaging "knative.dev/eventing/pkg/apis/messaging/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	dtdv0 "ktwin/operator/api/dtd/v0"
+	eventStore "ktwin/operator/pkg/event-store"
+)
+
+// cloudEventsEventTransport provisions a Knative Channel per TwinInterface
+// and a Subscription that forwards its events to the EventStore, for
+// clusters that don't run messaging-topology-operator. The Trigger passed to
+// EnsureRelationshipBindings is not used: this backend does not wire the
+// TwinInterface's Trigger to the Channel.
+type cloudEventsEventTransport struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// NewCloudEventsEventTransport returns the CloudEvents/Knative Channel
+// EventTransport backend.
+func NewCloudEventsEventTransport(c client.Client, scheme *runtime.Scheme) EventTransport {
+	return &cloudEventsEventTransport{Client: c, Scheme: scheme}
+}
+
+// Known TypeMeta for the Channel this backend provisions. Subscription.Spec.Channel
+// and the binding returned from EnsureRelationshipBindings both need these
+// values set explicitly rather than copied off a client-returned Channel:
+// the typed client commonly clears TypeMeta on objects it Gets/Lists.
+const (
+	channelKind       = "Channel"
+	channelAPIVersion = "messaging.knative.dev/v1"
+)
+
+// cloudEventsTopology is the concrete topology value returned by
+// EnsureInterfaceTopology and consumed by EnsureRelationshipBindings.
+type cloudEventsTopology struct {
+	channelName      string
+	channelNamespace string
+}
+
+func (t *cloudEventsEventTransport) EnsureInterfaceTopology(ctx context.Context, twinInterface *dtdv0.TwinInterface, brokerName string) (any, error) {
+	channel := kmessaging.Channel{
+		TypeMeta: v1.TypeMeta{
+			Kind:       channelKind,
+			APIVersion: channelAPIVersion,
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      twinInterface.Name,
+			Namespace: twinInterface.Namespace,
+			Labels: map[string]string{
+				BrokerLabel: brokerName,
+			},
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(twinInterface, &channel, t.Scheme); err != nil {
+		return nil, err
+	}
+
+	if err := t.Create(ctx, &channel, &client.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	return &cloudEventsTopology{channelName: channel.Name, channelNamespace: channel.Namespace}, nil
+}
+
+func (t *cloudEventsEventTransport) EnsureRelationshipBindings(ctx context.Context, twinInterface *dtdv0.TwinInterface, trigger keventing.Trigger, topology any) ([]client.Object, error) {
+	ceTopology := topology.(*cloudEventsTopology)
+
+	subscription := &kmessaging.Subscription{
+		TypeMeta: v1.TypeMeta{
+			Kind:       "Subscription",
+			APIVersion: "messaging.knative.dev/v1",
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-event-store", twinInterface.Name),
+			Namespace: twinInterface.Namespace,
+			Labels: map[string]string{
+				TriggerLabel: twinInterface.Name,
+			},
+		},
+		Spec: kmessaging.SubscriptionSpec{
+			Channel: corev1.ObjectReference{
+				APIVersion: channelAPIVersion,
+				Kind:       channelKind,
+				Name:       ceTopology.channelName,
+			},
+			Subscriber: &duckv1.Destination{
+				Ref: &duckv1.KReference{
+					APIVersion: "serving.knative.dev/v1",
+					Kind:       "Service",
+					Name:       eventStore.EVENT_STORE_SERVICE,
+					Namespace:  twinInterface.Namespace,
+				},
+			},
+		},
+	}
+
+	// channelRef is a bare reference to the Channel already created (and
+	// owned) in EnsureInterfaceTopology. It carries no ResourceVersion/UID,
+	// unlike a client-fetched copy would, so the reconciler's generic
+	// owner-reference/Create loop reliably gets back errors.IsAlreadyExists
+	// on every reconcile instead of the apiserver's
+	// "resourceVersion should not be set" rejection.
+	channelRef := &kmessaging.Channel{
+		TypeMeta: v1.TypeMeta{
+			Kind:       channelKind,
+			APIVersion: channelAPIVersion,
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      ceTopology.channelName,
+			Namespace: ceTopology.channelNamespace,
+		},
+	}
+
+	return []client.Object{channelRef, subscription}, nil
+}
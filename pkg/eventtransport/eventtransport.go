@@ -0,0 +1,79 @@
+package eventtransport
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dtdv0 "ktwin/operator/api/dtd/v0"
+	twinevent "ktwin/operator/pkg/event"
+	eventStore "ktwin/operator/pkg/event-store"
+
+	keventing "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+// Backend selects which EventTransport implementation a TwinInterface is
+// wired against.
+type Backend string
+
+const (
+	// BackendRabbitMQ provisions RabbitMQ Exchange/Queue/Binding topology via
+	// messaging-topology-operator CRDs. It is the default, matching the
+	// behavior ktwin has always had.
+	BackendRabbitMQ Backend = "RabbitMQ"
+	// BackendCloudEvents provisions Knative Channel/Subscription topology,
+	// for clusters that don't run messaging-topology-operator.
+	BackendCloudEvents Backend = "CloudEvents"
+)
+
+// Labels shared across backends to correlate broker-side objects with the
+// TwinInterface/Trigger they back.
+const (
+	BrokerLabel  = "eventing.knative.dev/broker"
+	TriggerLabel = "eventing.knative.dev/trigger"
+)
+
+// QueueBrokerTriggerIndex is a field indexer name keyed on both BrokerLabel
+// and TriggerLabel. The controller-runtime cache reader only supports a
+// single exact-match field selector per List call, so looking up the
+// per-interface Queue (which must match on broker AND trigger) needs one
+// composite indexed value rather than client.MatchingFields{two keys}.
+const QueueBrokerTriggerIndex = "eventing.knative.dev/broker-trigger"
+
+// BrokerTriggerIndexValue builds the composite value stored under
+// QueueBrokerTriggerIndex for a given broker/trigger pair.
+func BrokerTriggerIndexValue(brokerName, triggerName string) string {
+	return brokerName + "/" + triggerName
+}
+
+// EventStoreTriggerName is the well-known Trigger name every TwinInterface's
+// events are additionally routed to, so the EventStore can persist them.
+const EventStoreTriggerName = "event-store-trigger"
+
+// EventTransport provisions the messaging topology backing a TwinInterface's
+// event flow. Implementations exist per messaging technology (RabbitMQ,
+// CloudEvents/Knative Channels, ...) so the reconciler isn't hard-wired to
+// one.
+type EventTransport interface {
+	// EnsureInterfaceTopology resolves (or waits for) the broker-side objects
+	// a TwinInterface's bindings need, returning a backend-defined topology
+	// value to pass into EnsureRelationshipBindings. A nil topology with a
+	// nil error means the dataplane hasn't materialized yet and the caller
+	// should retry once the backend's Watches re-trigger reconciliation.
+	EnsureInterfaceTopology(ctx context.Context, twinInterface *dtdv0.TwinInterface, brokerName string) (any, error)
+	// EnsureRelationshipBindings builds the bindings that wire the
+	// TwinInterface's Trigger to the resolved topology and to the EventStore.
+	EnsureRelationshipBindings(ctx context.Context, twinInterface *dtdv0.TwinInterface, trigger keventing.Trigger, topology any) ([]client.Object, error)
+}
+
+// NewEventTransport resolves the EventTransport implementation for the given
+// backend, defaulting to BackendRabbitMQ when empty.
+func NewEventTransport(backend Backend, c client.Client, scheme *runtime.Scheme, twinEvent twinevent.TwinEvent, store eventStore.EventStore) EventTransport {
+	switch backend {
+	case BackendCloudEvents:
+		return NewCloudEventsEventTransport(c, scheme)
+	default:
+		return NewRabbitMQEventTransport(c, twinEvent, store)
+	}
+}
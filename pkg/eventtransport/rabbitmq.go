@@ -0,0 +1,98 @@
+package eventtransport
+
+import (
+	"context"
+
+	rabbitmqv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	keventing "knative.dev/eventing/pkg/apis/eventing/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dtdv0 "ktwin/operator/api/dtd/v0"
+	twinevent "ktwin/operator/pkg/event"
+	eventStore "ktwin/operator/pkg/event-store"
+)
+
+// rabbitmqEventTransport is the original ktwin backend: it wires a
+// TwinInterface's Trigger to RabbitMQ Queue/Exchange objects provisioned by
+// messaging-topology-operator.
+type rabbitmqEventTransport struct {
+	client.Client
+	TwinEvent  twinevent.TwinEvent
+	EventStore eventStore.EventStore
+}
+
+// NewRabbitMQEventTransport returns the RabbitMQ EventTransport backend.
+func NewRabbitMQEventTransport(c client.Client, twinEvent twinevent.TwinEvent, store eventStore.EventStore) EventTransport {
+	return &rabbitmqEventTransport{Client: c, TwinEvent: twinEvent, EventStore: store}
+}
+
+// rabbitmqTopology is the concrete topology value returned by
+// EnsureInterfaceTopology and consumed by EnsureRelationshipBindings.
+type rabbitmqTopology struct {
+	brokerExchange  rabbitmqv1beta1.Exchange
+	interfaceQueue  rabbitmqv1beta1.Queue
+	eventStoreQueue rabbitmqv1beta1.Queue
+}
+
+func (t *rabbitmqEventTransport) EnsureInterfaceTopology(ctx context.Context, twinInterface *dtdv0.TwinInterface, brokerName string) (any, error) {
+	eventStoreQueuesList := rabbitmqv1beta1.QueueList{}
+	err := t.List(ctx, &eventStoreQueuesList,
+		client.InNamespace(twinInterface.Namespace),
+		client.MatchingFields{TriggerLabel: EventStoreTriggerName},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(eventStoreQueuesList.Items) == 0 {
+		return nil, nil
+	}
+
+	exchangeList := rabbitmqv1beta1.ExchangeList{}
+	err = t.List(ctx, &exchangeList,
+		client.InNamespace(twinInterface.Namespace),
+		client.MatchingFields{BrokerLabel: brokerName},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(exchangeList.Items) == 0 {
+		return nil, nil
+	}
+
+	queueList := rabbitmqv1beta1.QueueList{}
+	err = t.List(ctx, &queueList,
+		client.InNamespace(twinInterface.Namespace),
+		client.MatchingFields{QueueBrokerTriggerIndex: BrokerTriggerIndexValue(brokerName, twinInterface.Name)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(queueList.Items) == 0 {
+		return nil, nil
+	}
+
+	return &rabbitmqTopology{
+		brokerExchange:  exchangeList.Items[0],
+		interfaceQueue:  queueList.Items[0],
+		eventStoreQueue: eventStoreQueuesList.Items[0],
+	}, nil
+}
+
+func (t *rabbitmqEventTransport) EnsureRelationshipBindings(ctx context.Context, twinInterface *dtdv0.TwinInterface, trigger keventing.Trigger, topology any) ([]client.Object, error) {
+	rmqTopology := topology.(*rabbitmqTopology)
+
+	var objects []client.Object
+
+	for _, binding := range t.TwinEvent.GetRelationshipBrokerBindings(twinInterface, trigger, rmqTopology.brokerExchange, rmqTopology.interfaceQueue) {
+		binding := binding
+		objects = append(objects, &binding)
+	}
+
+	for _, binding := range t.EventStore.GetEventStoreBrokerBindings(twinInterface, trigger, rmqTopology.brokerExchange, rmqTopology.eventStoreQueue) {
+		binding := binding
+		objects = append(objects, &binding)
+	}
+
+	return objects, nil
+}